@@ -0,0 +1,124 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sync"
+
+	"github.com/aarongreenlee/capture/game"
+	"github.com/aarongreenlee/capture/netio"
+)
+
+// conn is what a lobby needs from a player or spectator connection,
+// satisfied by both net.Conn and an ssh.Channel.
+type conn interface {
+	io.ReadWriteCloser
+}
+
+// lobby hosts one match at a time: it pairs the first two connections it
+// sees, and every connection that arrives after that joins the match
+// already under way as a read-only spectator instead of waiting for a
+// turn. A busy server doesn't fan out into several simultaneous games —
+// it's one table with a growing rail of people watching it.
+type lobby struct {
+	mu      sync.Mutex
+	waiting conn
+	active  *match
+	rules   game.Rules
+}
+
+func newLobby(rules game.Rules) *lobby {
+	return &lobby{rules: rules}
+}
+
+// join adds conn to the lobby: as a spectator if a match is in progress,
+// as the second player if someone is already waiting (which starts a new
+// match), or as the first player of the next match otherwise.
+func (l *lobby) join(c conn) {
+	l.mu.Lock()
+
+	if l.active != nil {
+		active := l.active
+		l.mu.Unlock()
+		active.addSpectator(c)
+		return
+	}
+
+	if l.waiting == nil {
+		l.waiting = c
+		l.mu.Unlock()
+		fmt.Fprintln(c, "Waiting for an opponent...")
+		return
+	}
+
+	opponent := l.waiting
+	l.waiting = nil
+	m := &match{}
+	l.active = m
+	l.mu.Unlock()
+
+	go l.play(opponent, c, m)
+}
+
+// match tracks the spectators watching a game in progress.
+type match struct {
+	mu         sync.Mutex
+	spectators []conn
+}
+
+func (m *match) addSpectator(c conn) {
+	m.mu.Lock()
+	m.spectators = append(m.spectators, c)
+	m.mu.Unlock()
+	fmt.Fprintln(c, "Spectating the current match (read-only)...")
+}
+
+func (m *match) broadcast(frame string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, s := range m.spectators {
+		netio.Broadcast(s, frame)
+	}
+}
+
+// play runs a full match between p1 and p2, broadcasting every frame to
+// m's spectators, and clears the lobby's active match when it ends.
+func (l *lobby) play(p1, p2 conn, m *match) {
+	defer func() {
+		l.mu.Lock()
+		l.active = nil
+		l.mu.Unlock()
+		p1.Close()
+		p2.Close()
+	}()
+
+	io1 := netio.New(p1)
+	io2 := netio.New(p2)
+	board := game.NewDefaultBoard(io1, io2)
+	board.SetRules(l.rules)
+
+	for board.Status() == game.InProgress {
+		board.Render()
+		m.broadcast(board.Frame())
+
+		playerIO := io1
+		if board.CurrentPlayer() != 0 {
+			playerIO = io2
+		}
+
+		if err := board.Turn(); err != nil {
+			if errors.Is(err, io.EOF) {
+				log.Printf("capture-server: a player disconnected mid-match")
+				return
+			}
+			playerIO.Display(fmt.Sprintf("Error: %s\n", err))
+			continue
+		}
+	}
+
+	board.Render()
+	m.broadcast(board.Frame())
+	log.Printf("capture-server: match finished with status %d", board.Status())
+}
@@ -0,0 +1,91 @@
+// Command capture-server hosts capture matches over TCP and/or SSH,
+// pairing waiting players two at a time and letting further connections
+// spectate the match already under way.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"sync"
+
+	"github.com/aarongreenlee/capture/game"
+)
+
+func main() {
+	listenTCP := flag.String("listen-tcp", "", "address to accept TCP player connections on, e.g. :4000")
+	listenSSH := flag.String("listen-ssh", "", "address to accept SSH player connections on, e.g. :4001")
+	hostKeyPath := flag.String("host-key", "capture_host_key", "path to the SSH host key, generated on first run if missing")
+	rulesName := flag.String("rules", "none", "cellular-automaton hazard rules to evolve Block cells each turn: conway, langton, or none")
+	rulesFile := flag.String("rules-file", "", "path to a Langton transition table, required when -rules=langton")
+	flag.Parse()
+
+	if *listenTCP == "" && *listenSSH == "" {
+		log.Fatal("capture-server: at least one of -listen-tcp or -listen-ssh is required")
+	}
+
+	rules, err := loadRules(*rulesName, *rulesFile)
+	if err != nil {
+		log.Fatalf("capture-server: %s", err)
+	}
+
+	lobby := newLobby(rules)
+
+	var wg sync.WaitGroup
+	if *listenTCP != "" {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := serveTCP(*listenTCP, lobby); err != nil {
+				log.Fatalf("capture-server: tcp listener: %s", err)
+			}
+		}()
+	}
+	if *listenSSH != "" {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := serveSSH(*listenSSH, *hostKeyPath, lobby); err != nil {
+				log.Fatalf("capture-server: ssh listener: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// loadRules opens path, if given, and builds the named Rules
+// implementation from it.
+func loadRules(name, path string) (game.Rules, error) {
+	var table io.Reader
+	if path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("rules: %w", err)
+		}
+		defer f.Close()
+		table = f
+	}
+	return game.RulesFromName(name, table)
+}
+
+// serveTCP accepts raw TCP connections and hands each straight to the
+// lobby.
+func serveTCP(addr string, lobby *lobby) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	log.Printf("capture-server: listening for tcp on %s", addr)
+
+	for {
+		c, err := listener.Accept()
+		if err != nil {
+			log.Printf("capture-server: tcp accept: %s", err)
+			continue
+		}
+		go lobby.join(c)
+	}
+}
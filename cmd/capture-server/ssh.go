@@ -0,0 +1,118 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"os"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// serveSSH accepts SSH connections and hands each session channel to the
+// lobby. Anyone may connect; the SSH transport is only here to give
+// players an encrypted, auth-free way to reach the lobby, the same way
+// you'd ssh into a remote game server.
+func serveSSH(addr, hostKeyPath string, lobby *lobby) error {
+	hostKey, err := loadOrCreateHostKey(hostKeyPath)
+	if err != nil {
+		return fmt.Errorf("host key: %w", err)
+	}
+
+	config := &ssh.ServerConfig{NoClientAuth: true}
+	config.AddHostKey(hostKey)
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	log.Printf("capture-server: listening for ssh on %s", addr)
+
+	for {
+		c, err := listener.Accept()
+		if err != nil {
+			log.Printf("capture-server: ssh accept: %s", err)
+			continue
+		}
+		go handleSSHConn(c, config, lobby)
+	}
+}
+
+// handleSSHConn completes the SSH handshake on c and forwards every
+// session channel opened on it to the lobby as a player or spectator
+// connection.
+func handleSSHConn(c net.Conn, config *ssh.ServerConfig, lobby *lobby) {
+	sshConn, channels, requests, err := ssh.NewServerConn(c, config)
+	if err != nil {
+		log.Printf("capture-server: ssh handshake: %s", err)
+		c.Close()
+		return
+	}
+	defer sshConn.Close()
+	go ssh.DiscardRequests(requests)
+
+	for newChannel := range channels {
+		if newChannel.ChannelType() != "session" {
+			newChannel.Reject(ssh.UnknownChannelType, "only session channels are supported")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			log.Printf("capture-server: ssh channel accept: %s", err)
+			continue
+		}
+		go acceptSessionRequests(requests)
+		go lobby.join(channel)
+	}
+}
+
+// acceptSessionRequests answers the handshake every interactive SSH
+// client performs on a session channel before it'll treat the session
+// as usable: shell, pty-req, and exec all need a "true" reply or the
+// client tears the connection down. Everything else is discarded.
+func acceptSessionRequests(requests <-chan *ssh.Request) {
+	for req := range requests {
+		switch req.Type {
+		case "shell", "pty-req", "exec":
+			if req.WantReply {
+				req.Reply(true, nil)
+			}
+		default:
+			if req.WantReply {
+				req.Reply(false, nil)
+			}
+		}
+	}
+}
+
+// loadOrCreateHostKey reads the SSH host key at path, generating and
+// saving a new one on first run.
+func loadOrCreateHostKey(path string) (ssh.Signer, error) {
+	data, err := os.ReadFile(path)
+	if err == nil {
+		return ssh.ParsePrivateKey(data)
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("generating host key: %w", err)
+	}
+	data = pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return nil, fmt.Errorf("saving host key: %w", err)
+	}
+	log.Printf("capture-server: generated new SSH host key at %s", path)
+
+	return ssh.ParsePrivateKey(data)
+}
@@ -0,0 +1,272 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/aarongreenlee/capture/game"
+	"github.com/aarongreenlee/capture/record"
+)
+
+func main() {
+	replayFile := flag.String("replay", "", "step through a saved .cap game file instead of playing")
+	savePath := flag.String("save", "", "write the finished game to path in .cap format, for sharing or -replay")
+	connect := flag.String("connect", "", "address of a capture-server to join, e.g. localhost:4000")
+	rulesName := flag.String("rules", "none", "cellular-automaton hazard rules to evolve Block cells each turn: conway, langton, or none")
+	rulesFile := flag.String("rules-file", "", "path to a Langton transition table, required when -rules=langton")
+	size := flag.Int("size", 0, "board size, NxN (defaults to 9)")
+	players := flag.Int("players", 2, "number of players sharing this terminal")
+	abilities := flag.String("abilities", "", "comma-separated abilities every player starts with, e.g. jump,bomb,wall")
+	flag.Parse()
+
+	switch {
+	case *replayFile != "":
+		if err := runReplay(*replayFile); err != nil {
+			fmt.Printf("Error: %s\n", err)
+			os.Exit(1)
+		}
+	case *connect != "":
+		if err := runClient(*connect); err != nil {
+			fmt.Printf("Error: %s\n", err)
+			os.Exit(1)
+		}
+	default:
+		rules, err := loadRules(*rulesName, *rulesFile)
+		if err != nil {
+			fmt.Printf("Error: %s\n", err)
+			os.Exit(1)
+		}
+		config, err := boardConfig(*size, *players, *abilities)
+		if err != nil {
+			fmt.Printf("Error: %s\n", err)
+			os.Exit(1)
+		}
+		runLocal(config, rules, *savePath)
+	}
+}
+
+// boardConfig builds a BoardConfig from the -size, -players, and
+// -abilities flags, parsing the abilities list once so every player
+// starts with the same inventory.
+func boardConfig(size, players int, abilityList string) (game.BoardConfig, error) {
+	if size < 0 {
+		return game.BoardConfig{}, fmt.Errorf("-size must be 0 or greater, got %d", size)
+	}
+	if players < 1 {
+		return game.BoardConfig{}, fmt.Errorf("-players must be 1 or greater, got %d", players)
+	}
+
+	config := game.BoardConfig{Size: size, Players: players}
+	if abilityList == "" {
+		return config, nil
+	}
+
+	names := strings.Split(abilityList, ",")
+	starting := make([]game.Ability, 0, len(names))
+	for _, name := range names {
+		ability, err := game.AbilityFromName(strings.TrimSpace(name))
+		if err != nil {
+			return game.BoardConfig{}, err
+		}
+		starting = append(starting, ability)
+	}
+
+	config.Abilities = make(map[int][]game.Ability, players)
+	for i := 0; i < players; i++ {
+		config.Abilities[i] = starting
+	}
+	return config, nil
+}
+
+// loadRules opens path, if given, and builds the named Rules
+// implementation from it.
+func loadRules(name, path string) (game.Rules, error) {
+	var table io.Reader
+	if path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("rules: %w", err)
+		}
+		defer f.Close()
+		table = f
+	}
+	return game.RulesFromName(name, table)
+}
+
+// runLocal plays a game against the local terminal, with every player
+// sharing the same console. If savePath is set, each finished game is
+// written there in .cap format before the players are asked to go again.
+func runLocal(config game.BoardConfig, rules game.Rules, savePath string) {
+	console := game.NewConsoleIO(os.Stdin, os.Stdout)
+	ios := make([]game.IO, config.Players)
+	for i := range ios {
+		ios[i] = console
+	}
+	board := game.NewBoard(config, ios)
+	board.SetRules(rules)
+
+	// We'll loop forever, but a finished game gives the players a chance to
+	// start a fresh board rather than trapping them here.
+	for {
+		// Everytime we iterate we will render the board.
+		board.Render()
+
+		if board.Status() != game.InProgress {
+			if savePath != "" {
+				if err := saveGame(&board, savePath); err != nil {
+					fmt.Printf("Error: %s\n", err)
+				}
+			}
+			if !playAgain() {
+				return
+			}
+			board = game.NewBoard(config, ios)
+			board.SetRules(rules)
+			continue
+		}
+
+		// After we render the board, let's ask the player what move they
+		// wish to make.
+		err := board.Turn()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			fmt.Printf("Error: %s\n", err)
+			continue
+		}
+	}
+}
+
+// saveGame writes board's history to path in .cap format, overwriting
+// whatever was there before.
+func saveGame(board *game.Board, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("save: %w", err)
+	}
+	defer f.Close()
+
+	if err := board.Save(f); err != nil {
+		return fmt.Errorf("save: %w", err)
+	}
+	fmt.Printf("Saved game to %s\n", path)
+	return nil
+}
+
+// playAgain asks whether the players want to start a new game now that the
+// current one has ended.
+func playAgain() bool {
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Print("Play again? (y/n): ")
+	answer, _ := reader.ReadString('\n')
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(answer)), "y")
+}
+
+// runReplay loads a saved game from path and steps through its moves one
+// at a time, waiting for the viewer to press enter between each.
+func runReplay(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("replay: %w", err)
+	}
+	defer f.Close()
+
+	header, moves, err := record.Read(f)
+	if err != nil {
+		return fmt.Errorf("replay: %w", err)
+	}
+
+	console := game.NewConsoleIO(os.Stdin, os.Stdout)
+	players := header.Players
+	if players == 0 {
+		players = 2 // records saved before the header carried a player count
+	}
+	ios := make([]game.IO, players)
+	for i := range ios {
+		ios[i] = console
+	}
+	board := game.NewBoard(game.BoardConfig{Size: header.Size, Players: players}, ios)
+	stdin := bufio.NewReader(os.Stdin)
+
+	board.Render()
+	for i, m := range moves {
+		if err := board.ApplyMove(m.Player, m.Position); err != nil {
+			return fmt.Errorf("replay: move %d: %w", i+1, err)
+		}
+
+		fmt.Printf("\nMove %d/%d: Player %d -> %s\n", i+1, len(moves), m.Player, m.Position)
+		board.Render()
+
+		if i < len(moves)-1 {
+			fmt.Print("Press enter for the next move...")
+			stdin.ReadString('\n')
+		}
+	}
+	return nil
+}
+
+// runClient joins a capture-server at addr. The server owns the board; the
+// client just shuttles rendered frames and, when prompted, the local
+// player's typed move over the connection.
+func runClient(addr string) error {
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+	defer conn.Close()
+
+	server := bufio.NewReader(conn)
+	stdin := bufio.NewReader(os.Stdin)
+
+	for {
+		line, err := server.ReadString('\n')
+		if err != nil {
+			if err == io.EOF {
+				fmt.Println("Disconnected from server.")
+				return nil
+			}
+			return fmt.Errorf("connect: %w", err)
+		}
+
+		switch {
+		case line == "FRAME\n":
+			frame, err := readFrame(server)
+			if err != nil {
+				return fmt.Errorf("connect: %w", err)
+			}
+			fmt.Print(frame)
+		case strings.HasPrefix(line, "PROMPT "):
+			player := strings.TrimSpace(strings.TrimPrefix(line, "PROMPT "))
+			fmt.Printf("[%s] Where would you like to move to?: ", player)
+			move, _ := stdin.ReadString('\n')
+			if _, err := fmt.Fprint(conn, move); err != nil {
+				return fmt.Errorf("connect: %w", err)
+			}
+		default:
+			fmt.Print(line)
+		}
+	}
+}
+
+// readFrame reads lines from r until the ENDFRAME marker, returning
+// everything in between.
+func readFrame(r *bufio.Reader) (string, error) {
+	var buf strings.Builder
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return "", err
+		}
+		if line == "ENDFRAME\n" {
+			return buf.String(), nil
+		}
+		buf.WriteString(line)
+	}
+}
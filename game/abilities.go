@@ -0,0 +1,154 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Ability is a special move a player can spend, outside the normal
+// adjacent-cell move rule. Args are whatever text followed the ability's
+// name in the player's "!name args..." command.
+type Ability interface {
+	Name() string
+	Apply(b *Board, player int, args ...string) error
+}
+
+// abilityRegistry looks up the stateless implementation behind an
+// ability's name.
+var abilityRegistry = map[string]Ability{
+	"jump": JumpAbility{},
+	"bomb": BombAbility{},
+	"wall": WallAbility{},
+}
+
+// abilityOrder fixes the display order abilities are listed in, so a
+// player's inventory renders the same way every frame.
+var abilityOrder = []string{"jump", "bomb", "wall"}
+
+// abilityBudget tallies a list of abilities into the name -> remaining
+// charges map a PlayerState carries.
+func abilityBudget(abilities []Ability) map[string]int {
+	if len(abilities) == 0 {
+		return nil
+	}
+	budget := make(map[string]int, len(abilities))
+	for _, a := range abilities {
+		budget[a.Name()]++
+	}
+	return budget
+}
+
+// parseAbilityCommand recognizes input of the form "!name args...". ok is
+// false for anything else, including a bare "!" with nothing after it.
+func parseAbilityCommand(text string) (name string, args []string, ok bool) {
+	if !strings.HasPrefix(text, "!") {
+		return "", nil, false
+	}
+	fields := strings.Fields(strings.TrimPrefix(text, "!"))
+	if len(fields) == 0 {
+		return "", nil, false
+	}
+	return strings.ToLower(fields[0]), fields[1:], true
+}
+
+// useAbility spends one of player's charges of name and applies it,
+// refusing if the player has none left, then records the ply so it
+// saves and replays like any other move.
+func (b *Board) useAbility(player int, name string, args []string) error {
+	ability, known := abilityRegistry[name]
+	if !known {
+		return fmt.Errorf("... ummm.... there's no ability called %q", name)
+	}
+	remaining := b.players[player].Abilities[name]
+	if remaining <= 0 {
+		return fmt.Errorf("... ummm.... you don't have any %s charges left", name)
+	}
+	if err := ability.Apply(b, player, args...); err != nil {
+		return err
+	}
+	b.players[player].Abilities[name] = remaining - 1
+	b.recordAbility(player, name, args)
+	return nil
+}
+
+// AbilityFromName looks up one of the built-in abilities by name, for
+// wiring up a BoardConfig from something like a CLI flag.
+func AbilityFromName(name string) (Ability, error) {
+	ability, ok := abilityRegistry[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("abilities: unknown ability %q (want jump, bomb, or wall)", name)
+	}
+	return ability, nil
+}
+
+// JumpAbility moves the player to any Available cell on the board,
+// ignoring the usual adjacency rule.
+type JumpAbility struct{}
+
+func (JumpAbility) Name() string { return "jump" }
+
+func (JumpAbility) Apply(b *Board, player int, args ...string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("... ummm.... jump needs a destination, like !jump A7")
+	}
+	row, col, err := b.parsePosition(args[0])
+	if err != nil {
+		return err
+	}
+	if b.Rows[row][col].Status != Available {
+		return fmt.Errorf("... ummm.... %s isn't open to jump to", args[0])
+	}
+	b.place(player, row, col)
+	return nil
+}
+
+// BombAbility clears the targeted cell and the 8 cells surrounding it
+// back to Available, destroying any Block trail caught in the blast.
+// Occupied cells are untouched, since a player can't be bombed out.
+type BombAbility struct{}
+
+func (BombAbility) Name() string { return "bomb" }
+
+func (BombAbility) Apply(b *Board, player int, args ...string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("... ummm.... bomb needs a target, like !bomb A7")
+	}
+	row, col, err := b.parsePosition(args[0])
+	if err != nil {
+		return err
+	}
+	for deltaRow := -1; deltaRow <= 1; deltaRow++ {
+		for deltaCol := -1; deltaCol <= 1; deltaCol++ {
+			r, c := row+deltaRow, col+deltaCol
+			if r < 0 || r >= len(b.Rows) || c < 0 || c >= len(b.Rows[r]) {
+				continue
+			}
+			if b.Rows[r][c].Status == Occupied {
+				continue
+			}
+			b.Rows[r][c] = newCell()
+		}
+	}
+	return nil
+}
+
+// WallAbility turns an Available cell into a permanent Block, anywhere
+// on the board, ignoring the usual adjacency rule.
+type WallAbility struct{}
+
+func (WallAbility) Name() string { return "wall" }
+
+func (WallAbility) Apply(b *Board, player int, args ...string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("... ummm.... wall needs a target, like !wall A7")
+	}
+	row, col, err := b.parsePosition(args[0])
+	if err != nil {
+		return err
+	}
+	if b.Rows[row][col].Status != Available {
+		return fmt.Errorf("... ummm.... %s isn't open to wall off", args[0])
+	}
+	b.Rows[row][col] = Cell{Status: Block, Occupant: -1}
+	return nil
+}
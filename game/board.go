@@ -0,0 +1,486 @@
+// Package game holds the capture board and rules, independent of how a
+// player's moves arrive or how a rendered frame is shown to them. That
+// plumbing is abstracted behind the IO interface so the same Board can be
+// driven by a local terminal, a saved record, or a network connection.
+package game
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aarongreenlee/capture/record"
+)
+
+// cellStatus is a custom type to help us avoid programming errors. We will
+// declare a few different known states for a cell below and reference them
+// when we update the board and render it.
+type cellStatus int
+
+const (
+	Available cellStatus = iota
+	Occupied
+	Block
+)
+
+// GameState describes where a match currently stands. A game starts
+// InProgress and moves to Finished once only one player is left standing,
+// or to Draw in the rare case everyone runs out of moves at once.
+type GameState int
+
+const (
+	InProgress GameState = iota
+	Finished
+	Draw
+)
+
+// Cell represents a single point or position on the board. Occupant is
+// only meaningful when Status is Occupied, and holds the index of the
+// player standing there.
+type Cell struct {
+	Status   cellStatus
+	Occupant int
+}
+
+// defaultGridSize is used when a BoardConfig doesn't specify a Size.
+const defaultGridSize = 9
+
+// defaultGlyphs are handed out to players in order, wrapping around if
+// there are more players than glyphs.
+var defaultGlyphs = []string{"X", "O", "△", "□", "◇", "+", "*", "#"}
+
+// Coord is a 0-indexed row/column position used to seed a player's
+// starting place on the board.
+type Coord struct {
+	Row, Col int
+}
+
+// BoardConfig describes the shape of a game: how big the grid is, how
+// many players take part, where (if anywhere) each one starts, and what
+// abilities, if any, they begin the game holding.
+type BoardConfig struct {
+	Size           int
+	Players        int
+	StartPositions []Coord
+	Abilities      map[int][]Ability
+}
+
+// PlayerState tracks one player's position, display, IO, and remaining
+// ability charges.
+type PlayerState struct {
+	Name      string
+	Glyph     string
+	Row, Col  int
+	IO        IO
+	Abilities map[string]int
+}
+
+// NewBoard creates a fresh board from config. ios[i] handles player i's
+// prompts and frames; a nil or short ios is fine for players who won't
+// be prompted (e.g. during replay).
+func NewBoard(config BoardConfig, ios []IO) Board {
+	size := config.Size
+	if size == 0 {
+		size = defaultGridSize
+	}
+	numPlayers := config.Players
+	if numPlayers == 0 {
+		numPlayers = 2
+	}
+
+	rows := make([][]Cell, 0, size)
+	for i := 0; i < size; i++ {
+		rows = append(rows, newRow(size))
+	}
+	columnMap, columnMapInverted := newColumnMaps(size)
+
+	players := make([]PlayerState, numPlayers)
+	alive := make([]bool, numPlayers)
+	for i := range players {
+		var playerIO IO
+		if i < len(ios) {
+			playerIO = ios[i]
+		}
+		players[i] = PlayerState{
+			Name:      fmt.Sprintf("Player %d", i+1),
+			Glyph:     defaultGlyphs[i%len(defaultGlyphs)],
+			IO:        playerIO,
+			Abilities: abilityBudget(config.Abilities[i]),
+		}
+		alive[i] = true
+	}
+
+	b := Board{
+		Rows:              rows,
+		size:              size,
+		players:           players,
+		alive:             alive,
+		winner:            -1,
+		startedAt:         time.Now(),
+		columnMap:         columnMap,
+		columnMapInverted: columnMapInverted,
+		config:            config,
+	}
+
+	for i, pos := range config.StartPositions {
+		if i >= len(b.players) {
+			break
+		}
+		b.Rows[pos.Row][pos.Col] = Cell{Status: Occupied, Occupant: i}
+		b.players[i].Row, b.players[i].Col = pos.Row, pos.Col
+	}
+
+	return b
+}
+
+// NewDefaultBoard creates the classic 9x9 two-player board, the shape
+// capture shipped with before boards became configurable.
+func NewDefaultBoard(io1, io2 IO) Board {
+	return NewBoard(BoardConfig{}, []IO{io1, io2})
+}
+
+func newRow(size int) []Cell {
+	columns := make([]Cell, 0, size)
+	for i := 0; i < size; i++ {
+		columns = append(columns, newCell())
+	}
+	return columns
+}
+
+func newCell() Cell {
+	return Cell{Status: Available, Occupant: -1}
+}
+
+// Board represents our main state.
+type Board struct {
+	// Rows manages the state of each individual cell.
+	Rows [][]Cell
+	size int
+
+	players []PlayerState
+	alive   []bool
+	turn    int
+
+	state  GameState
+	winner int
+
+	startedAt time.Time
+	history   []record.Move
+
+	columnMap         map[int]string
+	columnMapInverted map[string]int
+
+	config BoardConfig
+	rules  Rules
+}
+
+// Status reports whether the game is still being played and, if not,
+// whether it ended with a winner or a draw.
+func (b *Board) Status() GameState {
+	return b.state
+}
+
+// Winner reports the index of the player who won, or -1 if the game
+// isn't finished or ended in a draw.
+func (b *Board) Winner() int {
+	return b.winner
+}
+
+// CurrentPlayer reports the index of the player on the clock.
+func (b *Board) CurrentPlayer() int {
+	return b.turn
+}
+
+// PlayerName reports the display name of the player at index.
+func (b *Board) PlayerName(index int) string {
+	return b.players[index].Name
+}
+
+// Turn allows the current player to take a turn: either a normal move,
+// or an ability invoked as "!name args...".
+func (b *Board) Turn() error {
+	b.advanceToAlive()
+	player := b.turn
+
+	input, err := b.players[player].IO.Prompt(b.players[player].Name)
+	if err != nil {
+		return err
+	}
+	text := strings.TrimSpace(input)
+
+	if name, args, ok := parseAbilityCommand(text); ok {
+		if err := b.useAbility(player, name, args); err != nil {
+			return err
+		}
+	} else {
+		row, column, err := b.parsePosition(text)
+		if err != nil {
+			return err
+		}
+		if err := b.validateAdjacency(player, row, column); err != nil {
+			return err
+		}
+		b.move(player, row, column)
+	}
+
+	b.evolve()
+	b.advanceTurn()
+	b.updateStatus()
+	return nil
+}
+
+// advanceToAlive moves b.turn forward, if needed, until it lands on a
+// player still in the game.
+func (b *Board) advanceToAlive() {
+	for !b.alive[b.turn] {
+		b.turn = (b.turn + 1) % len(b.players)
+	}
+}
+
+// advanceTurn passes play to the next player still in the game.
+func (b *Board) advanceTurn() {
+	b.turn = (b.turn + 1) % len(b.players)
+	b.advanceToAlive()
+}
+
+// validateAdjacency confirms the requested cell is Available and one of
+// the eight cells touching the mover's current position. A player's very
+// first move is exempt from the adjacency check since they haven't been
+// placed on the board yet, but it still has to land on an open cell.
+func (b *Board) validateAdjacency(player, row, column int) error {
+	if b.Rows[row][column].Status != Available {
+		return fmt.Errorf("... ummm.... that cell isn't open to move to")
+	}
+
+	currentRow, currentCol := b.players[player].Row, b.players[player].Col
+	if currentRow == 0 {
+		return nil
+	}
+	rowDiff := row - currentRow
+	colDiff := column - currentCol
+	if rowDiff < -1 || rowDiff > 1 || colDiff < -1 || colDiff > 1 || (rowDiff == 0 && colDiff == 0) {
+		return fmt.Errorf("... ummm.... you can only move to a cell touching where you're standing")
+	}
+	return nil
+}
+
+// updateStatus eliminates any player now on the clock who has no legal
+// move left, repeating until either someone who can move is found or the
+// game is over.
+func (b *Board) updateStatus() {
+	for {
+		if b.aliveCount() <= 1 {
+			b.finish()
+			return
+		}
+
+		current := b.players[b.turn]
+		if current.Row == 0 || b.hasAvailableNeighbor(current.Row, current.Col) {
+			return
+		}
+
+		b.alive[b.turn] = false
+		b.advanceToAlive()
+	}
+}
+
+// aliveCount reports how many players are still in the game.
+func (b *Board) aliveCount() int {
+	count := 0
+	for _, alive := range b.alive {
+		if alive {
+			count++
+		}
+	}
+	return count
+}
+
+// finish settles the game once at most one player remains.
+func (b *Board) finish() {
+	if b.aliveCount() != 1 {
+		b.state = Draw
+		return
+	}
+	for i, alive := range b.alive {
+		if alive {
+			b.winner = i
+			break
+		}
+	}
+	b.state = Finished
+}
+
+// hasAvailableNeighbor scans the 8 cells surrounding row/col and reports
+// whether any of them is both Available and playable — row and column 0
+// are reserved for Frame's header labels, so they never count even
+// though they exist in b.Rows.
+func (b *Board) hasAvailableNeighbor(row, col int) bool {
+	for deltaRow := -1; deltaRow <= 1; deltaRow++ {
+		for deltaCol := -1; deltaCol <= 1; deltaCol++ {
+			if deltaRow == 0 && deltaCol == 0 {
+				continue
+			}
+			neighborRow, neighborCol := row+deltaRow, col+deltaCol
+			if neighborRow < 1 || neighborRow > b.size-1 {
+				continue
+			}
+			if neighborCol < 1 || neighborCol > b.size-1 {
+				continue
+			}
+			if b.Rows[neighborRow][neighborCol].Status == Available {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parsePosition turns column-letter/row-digit notation like "A7" or
+// "AA12" into the row/column indices used internally.
+func (b *Board) parsePosition(text string) (int, int, error) {
+	letters := 0
+	for letters < len(text) && text[letters] >= 'A' && text[letters] <= 'Z' {
+		letters++
+	}
+	if letters == 0 || letters == len(text) {
+		return -1, -1, fmt.Errorf("... ummm.... that is not a valid position. Try something like A7")
+	}
+
+	row, err := strconv.Atoi(text[letters:])
+	if err != nil {
+		return -1, -1, fmt.Errorf("... ummm.... are you taking this serious? Enter a position like A7")
+	}
+	if row > b.size-1 || row < 1 {
+		return -1, -1, fmt.Errorf("... ummm.... row %d does not exist on the board", row)
+	}
+	column, ok := b.columnMapInverted[text[:letters]]
+	if !ok {
+		return -1, -1, fmt.Errorf("... ummm.... column %s does not exist on the board", text[:letters])
+	}
+	return row, column, nil
+}
+
+// notation converts row/column indices back into column-letter/row-digit
+// notation like "A7", the inverse of parsePosition.
+func (b *Board) notation(row, column int) string {
+	return fmt.Sprintf("%s%d", b.columnMap[column-1], row)
+}
+
+// place moves player onto moveToRow/moveToColumn, leaving a Block behind
+// at their previous position, without touching b.history. move and the
+// abilities that reposition a player (JumpAbility) both build on this.
+func (b *Board) place(player, moveToRow, moveToColumn int) {
+	p := &b.players[player]
+	if p.Row > 0 {
+		b.Rows[p.Row][p.Col] = Cell{Status: Block, Occupant: -1}
+	}
+	b.Rows[moveToRow][moveToColumn] = Cell{Status: Occupied, Occupant: player}
+	p.Row, p.Col = moveToRow, moveToColumn
+}
+
+// move updates the internal state of the board based on the player's
+// validated move, and records it so it can be saved and replayed.
+func (b *Board) move(player, moveToRow, moveToColumn int) {
+	position := b.notation(moveToRow, moveToColumn)
+	b.place(player, moveToRow, moveToColumn)
+	b.history = append(b.history, record.Move{Player: player + 1, Position: position})
+}
+
+// recordAbility appends an ability ply to b.history, using the same
+// "!name args..." notation parseAbilityCommand reads back on replay, so
+// Bomb and Wall (which don't go through move) still save and replay
+// faithfully.
+func (b *Board) recordAbility(player int, name string, args []string) {
+	position := "!" + name
+	if len(args) > 0 {
+		position += " " + strings.Join(args, " ")
+	}
+	b.history = append(b.history, record.Move{Player: player + 1, Position: position})
+}
+
+// ApplyMove plays a single recorded ply on behalf of player (1-indexed)
+// without going through Turn's input parsing or adjacency check,
+// trusting it was already validated — typically because it came from a
+// saved record. position is either move notation like "A7" or an
+// ability command like "!wall B2", mirroring what Turn accepts.
+func (b *Board) ApplyMove(player int, position string) error {
+	index := player - 1
+	if index < 0 || index >= len(b.players) {
+		return fmt.Errorf("record: unknown player %d", player)
+	}
+	b.turn = index
+
+	if name, args, ok := parseAbilityCommand(position); ok {
+		if err := b.useAbility(index, name, args); err != nil {
+			return err
+		}
+	} else {
+		row, column, err := b.parsePosition(position)
+		if err != nil {
+			return err
+		}
+		b.move(index, row, column)
+	}
+
+	b.advanceTurn()
+	b.updateStatus()
+	return nil
+}
+
+// ios collects each player's IO, in index order.
+func (b *Board) ios() []IO {
+	ios := make([]IO, len(b.players))
+	for i, p := range b.players {
+		ios[i] = p.IO
+	}
+	return ios
+}
+
+// Save writes the game's header and full move history to w in the
+// record package's SGF-style format. Only the first two players' names
+// are recorded, since the record format predates boards with more.
+func (b *Board) Save(w io.Writer) error {
+	header := record.Header{
+		Game:    "capture",
+		Size:    b.size,
+		Players: len(b.players),
+		Date:    b.startedAt.Format("2006-01-02"),
+	}
+	if len(b.players) > 0 {
+		header.Player1 = b.players[0].Name
+	}
+	if len(b.players) > 1 {
+		header.Player2 = b.players[1].Name
+	}
+	return record.Write(w, header, b.history)
+}
+
+// Load replaces the board's state by reading a record from r and
+// replaying every move it contains, which naturally re-lays each
+// player's Block trail as it goes. The board's existing config and IO
+// are kept.
+func (b *Board) Load(r io.Reader) error {
+	header, moves, err := record.Read(r)
+	if err != nil {
+		return fmt.Errorf("record: failed to load game: %w", err)
+	}
+
+	fresh := NewBoard(b.config, b.ios())
+	if len(fresh.players) > 0 {
+		fresh.players[0].Name = header.Player1
+	}
+	if len(fresh.players) > 1 {
+		fresh.players[1].Name = header.Player2
+	}
+
+	for i, m := range moves {
+		if err := fresh.ApplyMove(m.Player, m.Position); err != nil {
+			return fmt.Errorf("record: move %d: %w", i+1, err)
+		}
+	}
+
+	*b = fresh
+	return nil
+}
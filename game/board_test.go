@@ -0,0 +1,102 @@
+package game
+
+import (
+	"errors"
+	"io"
+	"testing"
+)
+
+// scriptedIO answers Prompt with the next entry in moves, in order, and
+// returns io.EOF once the script runs out.
+type scriptedIO struct {
+	moves []string
+	next  int
+}
+
+func (s *scriptedIO) Prompt(player string) (string, error) {
+	if s.next >= len(s.moves) {
+		return "", io.EOF
+	}
+	move := s.moves[s.next]
+	s.next++
+	return move, nil
+}
+
+func (s *scriptedIO) Display(frame string) {}
+
+func TestTurnRejectsOutOfTurnOccupiedAndBlockedMoves(t *testing.T) {
+	p1 := &scriptedIO{moves: []string{"A1", "B2"}}
+	p2 := &scriptedIO{moves: []string{"A2"}}
+	board := NewDefaultBoard(p1, p2)
+
+	if err := board.Turn(); err != nil { // P1: A1
+		t.Fatalf("P1's opening move failed: %s", err)
+	}
+	if err := board.Turn(); err != nil { // P2: A2
+		t.Fatalf("P2's opening move failed: %s", err)
+	}
+	if err := board.Turn(); err != nil { // P1: B2, adjacent to A1
+		t.Fatalf("P1's second move failed: %s", err)
+	}
+
+	// P2 tries to move onto A1, which P1 vacated and left a Block behind.
+	p2.moves = append(p2.moves, "A1")
+	if err := board.Turn(); err == nil {
+		t.Fatal("expected moving onto a Block cell to be rejected, got nil error")
+	}
+
+	// P2 tries to move onto B2, which P1 currently occupies.
+	p2.moves = append(p2.moves, "B2")
+	if err := board.Turn(); err == nil {
+		t.Fatal("expected moving onto an opponent's cell to be rejected, got nil error")
+	}
+}
+
+func TestTurnRejectsNonAdjacentMove(t *testing.T) {
+	p1 := &scriptedIO{moves: []string{"A1", "H8"}}
+	p2 := &scriptedIO{moves: []string{"B1"}}
+	board := NewDefaultBoard(p1, p2)
+
+	if err := board.Turn(); err != nil { // P1: A1
+		t.Fatalf("P1's opening move failed: %s", err)
+	}
+	if err := board.Turn(); err != nil { // P2: B1
+		t.Fatalf("P2's opening move failed: %s", err)
+	}
+	if err := board.Turn(); err == nil {
+		t.Fatal("expected a move far from P1's current cell to be rejected, got nil error")
+	}
+}
+
+func TestTurnDetectsWinWhenOpponentHasNoMoves(t *testing.T) {
+	p1 := &scriptedIO{moves: []string{"A1", "A2"}}
+	p2 := &scriptedIO{moves: []string{"B1", "B2"}}
+	board := NewBoard(BoardConfig{Size: 3, Players: 2}, []IO{p1, p2})
+
+	// A 3x3 board has exactly one playable 2x2 area: A1, B1, A2, B2.
+	// P1: A1, P2: B1, P1: A2, P2: B2 (the only cell left open), which
+	// boxes P1 in with nowhere open to move and P2 wins.
+	for i := 0; i < 4; i++ {
+		if err := board.Turn(); err != nil {
+			t.Fatalf("turn %d failed: %s", i+1, err)
+		}
+	}
+
+	if status := board.Status(); status != Finished {
+		t.Fatalf("expected the game to finish once P1 had no moves left, got status %v", status)
+	}
+	if winner := board.Winner(); winner != 1 {
+		t.Fatalf("expected player 1 (P2) to win, got winner index %d", winner)
+	}
+}
+
+func TestTurnExhaustsIOAfterScriptedMoves(t *testing.T) {
+	p1 := &scriptedIO{}
+	p2 := &scriptedIO{}
+	board := NewDefaultBoard(p1, p2)
+
+	err := board.Turn()
+	if !errors.Is(err, io.EOF) {
+		t.Fatalf("expected io.EOF once a player's script runs dry, got %v", err)
+	}
+}
@@ -0,0 +1,43 @@
+package game
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// IO abstracts how a Board talks to a single player, so the same game
+// logic can run against a local terminal or be driven over a network
+// connection.
+type IO interface {
+	// Prompt asks the named player for their next move and returns their
+	// raw input.
+	Prompt(player string) (string, error)
+	// Display shows a rendered frame (the board plus any status banner)
+	// to the player.
+	Display(frame string)
+}
+
+// ConsoleIO is an IO backed by a local reader/writer, typically stdin and
+// stdout.
+type ConsoleIO struct {
+	reader *bufio.Reader
+	writer io.Writer
+}
+
+// NewConsoleIO wraps r and w as an IO for a player sitting at this
+// terminal.
+func NewConsoleIO(r io.Reader, w io.Writer) *ConsoleIO {
+	return &ConsoleIO{reader: bufio.NewReader(r), writer: w}
+}
+
+// Prompt implements IO.
+func (c *ConsoleIO) Prompt(player string) (string, error) {
+	fmt.Fprintf(c.writer, "[%s] Where would you like to move to?: ", player)
+	return c.reader.ReadString('\n')
+}
+
+// Display implements IO.
+func (c *ConsoleIO) Display(frame string) {
+	fmt.Fprint(c.writer, frame)
+}
@@ -0,0 +1,129 @@
+package game
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// Render renders the current frame and sends it to every player via
+// their IO.
+func (b *Board) Render() {
+	frame := b.Frame()
+	for _, p := range b.players {
+		if p.IO != nil {
+			p.IO.Display(frame)
+		}
+	}
+}
+
+// Frame renders the board, plus any outcome banner and ability
+// inventory, as a single string. It's exposed separately from Render so
+// callers such as a game server can forward the same frame on to
+// spectators.
+func (b *Board) Frame() string {
+	var buf strings.Builder
+	table := tablewriter.NewWriter(&buf)
+
+	for i, row := range b.Rows {
+		renderedRow := make([]string, 0, b.size)
+		for j, cell := range row {
+			// Render the top-left cell
+			if i == 0 && j == 0 {
+				renderedRow = append(renderedRow, "")
+				continue
+			}
+			// Render the row number
+			if i == 0 {
+				renderedRow = append(renderedRow, b.columnMap[j-1])
+				continue
+			}
+			// Render the column letter
+			if j == 0 {
+				renderedRow = append(renderedRow, strconv.Itoa(i))
+				continue
+			}
+			// Render an interior column
+			renderedRow = append(renderedRow, b.renderCell(cell))
+		}
+		table.Append(renderedRow)
+	}
+	table.Render()
+
+	switch b.state {
+	case InProgress:
+		// Nothing to announce, the game is still being played.
+	case Finished:
+		fmt.Fprintf(&buf, "%s wins! Everyone else has nowhere left to move.\n", b.players[b.winner].Name)
+	case Draw:
+		fmt.Fprintln(&buf, "It's a draw!")
+	default:
+		panic("unknown game state")
+	}
+
+	for _, p := range b.players {
+		if len(p.Abilities) == 0 {
+			continue
+		}
+		fmt.Fprintf(&buf, "%s abilities:", p.Name)
+		for _, name := range abilityOrder {
+			if remaining, ok := p.Abilities[name]; ok {
+				fmt.Fprintf(&buf, " %s x%d", name, remaining)
+			}
+		}
+		fmt.Fprintln(&buf)
+	}
+
+	return buf.String()
+}
+
+// renderCell converts the internal state of a cell into its on-screen
+// glyph.
+func (b *Board) renderCell(c Cell) string {
+	switch c.Status {
+	case Available:
+		return ""
+	case Occupied:
+		return b.players[c.Occupant].Glyph
+	case Block:
+		return "~"
+	default:
+		panic("unknown cell status")
+	}
+}
+
+// newColumnMaps builds the column-letter lookup tables for a board of
+// the given size, extending past Z into spreadsheet-style AA, AB, ...
+// once there are more than 26 columns. Row 0 and column 0 of the grid
+// are reserved for the header labels drawn in Frame, so there are only
+// size-1 playable columns.
+func newColumnMaps(size int) (map[int]string, map[string]int) {
+	playable := size - 1
+	columnMap := make(map[int]string, playable)
+	columnMapInverted := make(map[string]int, playable)
+	for i := 0; i < playable; i++ {
+		letter := columnName(i)
+		columnMap[i] = letter
+		columnMapInverted[letter] = i + 1
+	}
+	return columnMap, columnMapInverted
+}
+
+// columnName converts a 0-indexed column into spreadsheet-style letters:
+// 0 -> "A", 25 -> "Z", 26 -> "AA", and so on.
+func columnName(i int) string {
+	var name strings.Builder
+	n := i + 1
+	for n > 0 {
+		n--
+		name.WriteByte(byte('A' + n%26))
+		n /= 26
+	}
+	letters := []byte(name.String())
+	for l, r := 0, len(letters)-1; l < r; l, r = l+1, r-1 {
+		letters[l], letters[r] = letters[r], letters[l]
+	}
+	return string(letters)
+}
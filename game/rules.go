@@ -0,0 +1,220 @@
+package game
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Rules is a cellular-automaton rule applied to every cell once per turn,
+// evolving the battlefield around the players. neighborhood holds the 8
+// cells surrounding the one being evaluated, in clockwise order starting
+// north: N, NE, E, SE, S, SW, W, NW. Cells beyond the edge of the board
+// are reported as Available.
+type Rules interface {
+	Next(neighborhood [8]cellStatus, current cellStatus) cellStatus
+}
+
+// evolve advances the board one generation under its Rules, if any.
+// Player-occupied cells are immovable — they never change — but they do
+// count as neighbors for the cells around them.
+func (b *Board) evolve() {
+	if b.rules == nil {
+		return
+	}
+
+	next := make([][]Cell, len(b.Rows))
+	for i, row := range b.Rows {
+		next[i] = make([]Cell, len(row))
+		copy(next[i], row)
+
+		if i < 1 || i > b.size-1 {
+			continue // row 0 is reserved for Frame's header labels
+		}
+
+		for j, cell := range row {
+			if j < 1 || j > b.size-1 {
+				continue // column 0 is reserved for Frame's header labels
+			}
+			if cell.Status == Occupied {
+				continue
+			}
+			next[i][j] = Cell{Status: b.rules.Next(b.neighborhood(i, j), cell.Status), Occupant: -1}
+		}
+	}
+
+	b.Rows = next
+}
+
+// neighborhood reports the status of the 8 cells surrounding row/col, in
+// clockwise order starting north. Off-board neighbors, and row 0/column 0
+// which are reserved for Frame's header labels, all read as Available.
+func (b *Board) neighborhood(row, col int) [8]cellStatus {
+	deltas := [8][2]int{
+		{-1, 0}, {-1, 1}, {0, 1}, {1, 1},
+		{1, 0}, {1, -1}, {0, -1}, {-1, -1},
+	}
+	var n [8]cellStatus
+	for i, d := range deltas {
+		r, c := row+d[0], col+d[1]
+		if r < 1 || r > b.size-1 || c < 1 || c > b.size-1 {
+			n[i] = Available
+			continue
+		}
+		n[i] = b.Rows[r][c].Status
+	}
+	return n
+}
+
+// SetRules installs the cellular-automaton rules evolved once per turn,
+// or clears them when r is nil.
+func (b *Board) SetRules(r Rules) {
+	b.rules = r
+}
+
+// ConwayRules evolves Block cells with Conway's Game of Life B3/S23 rule:
+// a Block survives with 2 or 3 live neighbors, and an Available cell is
+// born as a Block with exactly 3. Any occupied or blocked neighbor counts
+// as alive, so trails left behind by the players decay and drift into
+// shifting terrain.
+type ConwayRules struct{}
+
+// Next implements Rules.
+func (ConwayRules) Next(neighborhood [8]cellStatus, current cellStatus) cellStatus {
+	live := 0
+	for _, n := range neighborhood {
+		if n != Available {
+			live++
+		}
+	}
+	if current == Block {
+		if live == 2 || live == 3 {
+			return Block
+		}
+		return Available
+	}
+	if live == 3 {
+		return Block
+	}
+	return Available
+}
+
+// LangtonRules evolves cells via a user-supplied transition table keyed
+// on a cell's own status plus its four orthogonal neighbors, letting
+// players experiment with self-replicating hazard patterns.
+type LangtonRules struct {
+	table map[langtonKey]cellStatus
+}
+
+type langtonKey struct {
+	center, north, east, south, west cellStatus
+}
+
+// LoadLangtonRules parses a transition table from r. Each non-blank,
+// non-comment line has the form:
+//
+//	center,north,east,south,west -> next
+//
+// where each status is one of: available, block, occupied (or the
+// shorthand ., #, *). occupied may only appear on the left of "->" — a
+// rule can read that a neighbor is a player, but can't manufacture one,
+// since evolve never hands a player's own cell to Next. A neighborhood
+// combination missing from the table leaves the cell unchanged.
+func LoadLangtonRules(r io.Reader) (*LangtonRules, error) {
+	rules := &LangtonRules{table: make(map[langtonKey]cellStatus)}
+
+	scanner := bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		parts := strings.SplitN(line, "->", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("rules: line %d: expected \"center,N,E,S,W -> next\"", lineNum)
+		}
+		states, err := parseStatusList(strings.TrimSpace(parts[0]), 5)
+		if err != nil {
+			return nil, fmt.Errorf("rules: line %d: %w", lineNum, err)
+		}
+		next, err := parseStatus(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("rules: line %d: %w", lineNum, err)
+		}
+		if next == Occupied {
+			return nil, fmt.Errorf("rules: line %d: a rule can't transition a cell to occupied", lineNum)
+		}
+
+		key := langtonKey{center: states[0], north: states[1], east: states[2], south: states[3], west: states[4]}
+		rules.table[key] = next
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("rules: %w", err)
+	}
+	return rules, nil
+}
+
+// Next implements Rules.
+func (l *LangtonRules) Next(neighborhood [8]cellStatus, current cellStatus) cellStatus {
+	key := langtonKey{
+		center: current,
+		north:  neighborhood[0],
+		east:   neighborhood[2],
+		south:  neighborhood[4],
+		west:   neighborhood[6],
+	}
+	if next, ok := l.table[key]; ok {
+		return next
+	}
+	return current
+}
+
+func parseStatusList(s string, n int) ([]cellStatus, error) {
+	fields := strings.Split(s, ",")
+	if len(fields) != n {
+		return nil, fmt.Errorf("expected %d comma-separated statuses, got %d", n, len(fields))
+	}
+	statuses := make([]cellStatus, n)
+	for i, f := range fields {
+		status, err := parseStatus(strings.TrimSpace(f))
+		if err != nil {
+			return nil, err
+		}
+		statuses[i] = status
+	}
+	return statuses, nil
+}
+
+func parseStatus(s string) (cellStatus, error) {
+	switch strings.ToLower(s) {
+	case "available", ".":
+		return Available, nil
+	case "block", "#":
+		return Block, nil
+	case "occupied", "*":
+		return Occupied, nil
+	default:
+		return 0, fmt.Errorf("unknown cell status %q", s)
+	}
+}
+
+// RulesFromName builds the named Rules implementation. table is only
+// consulted for "langton", which requires a transition table; "conway"
+// and "none" ignore it.
+func RulesFromName(name string, table io.Reader) (Rules, error) {
+	switch name {
+	case "", "none":
+		return nil, nil
+	case "conway":
+		return ConwayRules{}, nil
+	case "langton":
+		if table == nil {
+			return nil, fmt.Errorf("rules: a -rules-file is required for langton rules")
+		}
+		return LoadLangtonRules(table)
+	default:
+		return nil, fmt.Errorf("rules: unknown rules %q (want conway, langton, or none)", name)
+	}
+}
@@ -0,0 +1,51 @@
+package game
+
+import "testing"
+
+// TestEvolveNeverTouchesHeaderRowOrColumn guards against evolve treating
+// row 0 or column 0 (Frame's header labels, never playable) as an
+// ordinary cell: seeding Block cells near the edge used to be enough to
+// turn a header cell into a Block, which then fed back in as a phantom
+// live neighbor for real playable cells next generation.
+func TestEvolveNeverTouchesHeaderRowOrColumn(t *testing.T) {
+	p1 := &scriptedIO{}
+	p2 := &scriptedIO{}
+	board := NewBoard(BoardConfig{Size: 5, Players: 2}, []IO{p1, p2})
+	board.SetRules(ConwayRules{})
+
+	// Surround the row-1/col-0 header label cell with enough Block
+	// neighbors to satisfy Conway's birth rule, if evolve wrongly let it
+	// be born.
+	board.Rows[1][1] = Cell{Status: Block, Occupant: -1}
+	board.Rows[2][1] = Cell{Status: Block, Occupant: -1}
+	board.Rows[1][2] = Cell{Status: Block, Occupant: -1}
+
+	board.evolve()
+
+	if status := board.Rows[1][0].Status; status == Block {
+		t.Fatalf("expected the row-1 header label cell to stay untouched, got %v", status)
+	}
+	if status := board.Rows[0][1].Status; status == Block {
+		t.Fatalf("expected the col-1 header label cell to stay untouched, got %v", status)
+	}
+}
+
+// TestNeighborhoodReportsHeaderCellsAsAvailable guards against a
+// playable edge cell counting a header label cell as a live neighbor:
+// row 0 and column 0 must always read as Available, regardless of
+// whatever status happens to be stored there.
+func TestNeighborhoodReportsHeaderCellsAsAvailable(t *testing.T) {
+	p1 := &scriptedIO{}
+	p2 := &scriptedIO{}
+	board := NewBoard(BoardConfig{Size: 5, Players: 2}, []IO{p1, p2})
+
+	board.Rows[0][1] = Cell{Status: Block, Occupant: -1}
+	board.Rows[1][0] = Cell{Status: Block, Occupant: -1}
+
+	n := board.neighborhood(1, 1)
+	for i, status := range n {
+		if status != Available {
+			t.Fatalf("neighbor %d: expected header cells to read as Available, got %v", i, status)
+		}
+	}
+}
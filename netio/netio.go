@@ -0,0 +1,45 @@
+// Package netio implements game.IO over a connection, using a simple
+// line-based protocol: a frame is wrapped between FRAME/ENDFRAME marker
+// lines, and a move is requested with a PROMPT line followed by reading
+// one line back from the remote side.
+package netio
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/aarongreenlee/capture/game"
+)
+
+// IO is a game.IO that prompts and displays over a network connection.
+type IO struct {
+	reader *bufio.Reader
+	writer io.Writer
+}
+
+var _ game.IO = (*IO)(nil)
+
+// New wraps rw as an IO for a single remote player.
+func New(rw io.ReadWriter) *IO {
+	return &IO{reader: bufio.NewReader(rw), writer: rw}
+}
+
+// Display implements game.IO.
+func (n *IO) Display(frame string) {
+	fmt.Fprintf(n.writer, "FRAME\n%s\nENDFRAME\n", frame)
+}
+
+// Prompt implements game.IO.
+func (n *IO) Prompt(player string) (string, error) {
+	if _, err := fmt.Fprintf(n.writer, "PROMPT %s\n", player); err != nil {
+		return "", err
+	}
+	return n.reader.ReadString('\n')
+}
+
+// Broadcast sends frame directly to w, for spectators who watch a match
+// without taking part in its prompts.
+func Broadcast(w io.Writer, frame string) {
+	fmt.Fprintf(w, "FRAME\n%s\nENDFRAME\n", frame)
+}
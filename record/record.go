@@ -0,0 +1,146 @@
+// Package record implements a small SGF-inspired text format for saving
+// and replaying capture games: a header of bracketed key-value properties
+// followed by a semicolon-delimited list of moves, e.g.
+//
+//	GM[capture]SZ[9]PN[2]P1[nick]P2[nick]DT[2026-07-27];1[A5];2[B5];1[B6]
+package record
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Header carries the game metadata that precedes the move list. Players
+// is 0 for records written before boards supported more than two
+// players, matching the same "0 means 2" convention game.NewBoard uses.
+type Header struct {
+	Game    string
+	Size    int
+	Players int
+	Player1 string
+	Player2 string
+	Date    string
+}
+
+// Move is a single recorded ply: which player moved, and the
+// column-letter/row-digit notation for the cell they moved to.
+type Move struct {
+	Player   int
+	Position string
+}
+
+// Write serializes header followed by every move in moves, in order.
+func Write(w io.Writer, header Header, moves []Move) error {
+	if _, err := fmt.Fprintf(w, "GM[%s]SZ[%d]PN[%d]P1[%s]P2[%s]DT[%s]",
+		header.Game, header.Size, header.Players, header.Player1, header.Player2, header.Date); err != nil {
+		return err
+	}
+	for _, m := range moves {
+		if _, err := fmt.Fprintf(w, ";%d[%s]", m.Player, m.Position); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+// Read parses a record previously produced by Write.
+func Read(r io.Reader) (Header, []Move, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return Header{}, nil, fmt.Errorf("record: reading record: %w", err)
+		}
+		return Header{}, nil, fmt.Errorf("record: empty record")
+	}
+
+	headerPart, movesPart := splitHeaderAndMoves(scanner.Text())
+
+	header, err := parseHeader(headerPart)
+	if err != nil {
+		return Header{}, nil, err
+	}
+	moves, err := parseMoves(movesPart)
+	if err != nil {
+		return Header{}, nil, err
+	}
+	return header, moves, nil
+}
+
+// splitHeaderAndMoves separates the leading bracketed header properties
+// from the semicolon-delimited move list that follows them.
+func splitHeaderAndMoves(line string) (header, moves string) {
+	if idx := strings.Index(line, ";"); idx >= 0 {
+		return line[:idx], line[idx:]
+	}
+	return line, ""
+}
+
+func parseHeader(s string) (Header, error) {
+	header := Header{
+		Game:    propOrEmpty(s, "GM"),
+		Player1: propOrEmpty(s, "P1"),
+		Player2: propOrEmpty(s, "P2"),
+		Date:    propOrEmpty(s, "DT"),
+	}
+	if sz, ok := prop(s, "SZ"); ok {
+		size, err := strconv.Atoi(sz)
+		if err != nil {
+			return Header{}, fmt.Errorf("record: invalid SZ property %q: %w", sz, err)
+		}
+		header.Size = size
+	}
+	if pn, ok := prop(s, "PN"); ok {
+		players, err := strconv.Atoi(pn)
+		if err != nil {
+			return Header{}, fmt.Errorf("record: invalid PN property %q: %w", pn, err)
+		}
+		header.Players = players
+	}
+	return header, nil
+}
+
+func propOrEmpty(s, key string) string {
+	v, _ := prop(s, key)
+	return v
+}
+
+// prop extracts the bracketed value following key, e.g. prop("GM[capture]", "GM")
+// returns ("capture", true).
+func prop(s, key string) (string, bool) {
+	marker := key + "["
+	start := strings.Index(s, marker)
+	if start < 0 {
+		return "", false
+	}
+	start += len(marker)
+	end := strings.Index(s[start:], "]")
+	if end < 0 {
+		return "", false
+	}
+	return s[start : start+end], true
+}
+
+func parseMoves(s string) ([]Move, error) {
+	var moves []Move
+	for _, entry := range strings.Split(s, ";") {
+		if entry == "" {
+			continue
+		}
+		open := strings.Index(entry, "[")
+		close := strings.Index(entry, "]")
+		if open < 0 || close < open {
+			return nil, fmt.Errorf("record: malformed move entry %q", entry)
+		}
+		player, err := strconv.Atoi(entry[:open])
+		if err != nil {
+			return nil, fmt.Errorf("record: invalid player in move entry %q: %w", entry, err)
+		}
+		moves = append(moves, Move{Player: player, Position: entry[open+1 : close]})
+	}
+	return moves, nil
+}